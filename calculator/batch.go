@@ -0,0 +1,71 @@
+package calculator
+
+import (
+	"strconv"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/jutinko/shipping_cost_calculator/utilities"
+)
+
+// batchLookupConcurrency bounds how many carts GetPrices prices at once,
+// and so how many ProductStore.Get calls a batch can have in flight
+// simultaneously.
+const batchLookupConcurrency = 8
+
+// Result is the outcome of pricing one cart within a GetPrices batch.
+// Exactly one of Price and Err is meaningful.
+type Result struct {
+	Price PriceBreakdown
+	Err   error
+}
+
+// GetPrices prices many carts in one call. Unlike GetPrice, a failure
+// pricing one cart — an unknown SKU, say — does not abort the rest of the
+// batch: it is reported in that cart's Result.Err while every other cart
+// still gets its Result.Price. Carts are fanned out across a bounded worker
+// pool, and product lookups are coalesced across the whole batch, so a SKU
+// shared by several carts that are priced concurrently costs the
+// ProductStore a single Get call.
+func (c *OrderCalculator) GetPrices(batches [][]*ProductOrder) ([]Result, error) {
+	cartCalculator := NewOrderCalculator(&coalescingProductStore{store: c.productStore}, c.shippingCalculator, c.currencyConverter, c.promotions, c.packingStrategy)
+
+	sem := make(chan struct{}, batchLookupConcurrency)
+	var wg sync.WaitGroup
+	results := make([]Result, len(batches))
+
+	for i, orders := range batches {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, orders []*ProductOrder) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			price, err := cartCalculator.GetPrice(orders, "")
+			results[i] = Result{Price: price, Err: err}
+		}(i, orders)
+	}
+
+	wg.Wait()
+	return results, nil
+}
+
+// coalescingProductStore wraps a ProductStore so that concurrent Get calls
+// for the same SKU are coalesced into a single underlying call via
+// singleflight, with the result fanned back out to every caller.
+type coalescingProductStore struct {
+	store ProductStore
+	group singleflight.Group
+}
+
+func (s *coalescingProductStore) Get(sku int) (*utilities.Product, error) {
+	v, err, _ := s.group.Do(strconv.Itoa(sku), func() (interface{}, error) {
+		return s.store.Get(sku)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*utilities.Product), nil
+}
@@ -0,0 +1,116 @@
+package calculator
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/jutinko/shipping_cost_calculator/utilities"
+)
+
+// TransientError marks an error as transient: the same call is expected to
+// succeed if retried, as with a network timeout or a 503 from an external
+// service. ProductStore and ShippingCalculator implementations should wrap
+// such failures in a TransientError so BatchRetry knows which errors are
+// safe to retry.
+type TransientError struct {
+	Err error
+}
+
+// NewTransientError wraps err as a TransientError.
+func NewTransientError(err error) TransientError {
+	return TransientError{Err: err}
+}
+
+func (e TransientError) Error() string { return e.Err.Error() }
+
+func (e TransientError) Unwrap() error { return e.Err }
+
+func isTransient(err error) bool {
+	var transient TransientError
+	return errors.As(err, &transient)
+}
+
+// BatchRetry is a retry policy for the transient store/shipping errors a
+// GetPrices batch can hit: a failed call is retried with exponential
+// backoff and jitter, up to MaxAttempts attempts, mirroring the
+// batch-place/batch-retry pattern used by trading SDKs. Non-transient
+// errors are returned immediately, without retrying.
+type BatchRetry struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+}
+
+// NewBatchRetry builds a BatchRetry that retries a failed call up to
+// maxAttempts times in total, with the delay between attempts doubling
+// from baseDelay and up to baseDelay of jitter added on top each time.
+func NewBatchRetry(maxAttempts int, baseDelay time.Duration) *BatchRetry {
+	return &BatchRetry{MaxAttempts: maxAttempts, BaseDelay: baseDelay}
+}
+
+// ProductStore wraps store so its Get calls are retried per r's policy.
+func (r *BatchRetry) ProductStore(store ProductStore) ProductStore {
+	return retryingProductStore{store: store, retry: r}
+}
+
+// ShippingCalculator wraps calc so its Calculate calls are retried per r's
+// policy.
+func (r *BatchRetry) ShippingCalculator(calc ShippingCalculator) ShippingCalculator {
+	return retryingShippingCalculator{calc: calc, retry: r}
+}
+
+// do calls fn, retrying while it returns a TransientError, until it
+// succeeds or MaxAttempts attempts have been made. Returns the last error
+// if every attempt fails.
+func (r *BatchRetry) do(fn func() error) error {
+	var err error
+	for attempt := 1; attempt <= r.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			time.Sleep(r.backoff(attempt))
+		}
+
+		err = fn()
+		if err == nil || !isTransient(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// backoff returns the delay before retry attempt n (n >= 2): BaseDelay
+// doubled n-2 times, plus up to BaseDelay of random jitter, so that many
+// retrying clients don't all retry in lockstep.
+func (r *BatchRetry) backoff(attempt int) time.Duration {
+	delay := r.BaseDelay << (attempt - 2)
+	return delay + time.Duration(rand.Int63n(int64(r.BaseDelay)+1))
+}
+
+type retryingProductStore struct {
+	store ProductStore
+	retry *BatchRetry
+}
+
+func (s retryingProductStore) Get(sku int) (*utilities.Product, error) {
+	var product *utilities.Product
+	err := s.retry.do(func() error {
+		var err error
+		product, err = s.store.Get(sku)
+		return err
+	})
+	return product, err
+}
+
+type retryingShippingCalculator struct {
+	calc  ShippingCalculator
+	retry *BatchRetry
+}
+
+func (s retryingShippingCalculator) Calculate(parcels []utilities.Parcel) (ShippingQuote, error) {
+	var quote ShippingQuote
+	err := s.retry.do(func() error {
+		var err error
+		quote, err = s.calc.Calculate(parcels)
+		return err
+	})
+	return quote, err
+}
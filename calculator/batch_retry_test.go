@@ -0,0 +1,98 @@
+package calculator_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/jutinko/shipping_cost_calculator/calculator"
+	"github.com/jutinko/shipping_cost_calculator/calculator/fakes"
+	"github.com/jutinko/shipping_cost_calculator/money"
+	"github.com/jutinko/shipping_cost_calculator/utilities"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BatchRetry", func() {
+	Describe("ProductStore", func() {
+		var fakeProductStore *fakes.FakeProductStore
+
+		BeforeEach(func() {
+			fakeProductStore = new(fakes.FakeProductStore)
+		})
+
+		It("retries a transient error from the wrapped store until it succeeds", func() {
+			calls := 0
+			fakeProductStore.GetStub = func(sku int) (*utilities.Product, error) {
+				calls++
+				if calls < 3 {
+					return nil, NewTransientError(errors.New("temporary outage"))
+				}
+				return &utilities.Product{Sku: sku}, nil
+			}
+
+			store := NewBatchRetry(5, time.Millisecond).ProductStore(fakeProductStore)
+
+			product, err := store.Get(20)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(product.Sku).To(Equal(20))
+			Expect(calls).To(Equal(3))
+		})
+
+		It("does not retry a non-transient error", func() {
+			fakeProductStore.GetReturns(nil, errors.New("no such sku"))
+
+			store := NewBatchRetry(5, time.Millisecond).ProductStore(fakeProductStore)
+
+			_, err := store.Get(20)
+			Expect(err).To(MatchError("no such sku"))
+			Expect(fakeProductStore.GetCallCount()).To(Equal(1))
+		})
+
+		It("surfaces the last error once attempts are exhausted", func() {
+			fakeProductStore.GetReturns(nil, NewTransientError(errors.New("still down")))
+
+			store := NewBatchRetry(3, time.Millisecond).ProductStore(fakeProductStore)
+
+			_, err := store.Get(20)
+			Expect(err).To(MatchError("still down"))
+			Expect(fakeProductStore.GetCallCount()).To(Equal(3))
+		})
+	})
+
+	Describe("ShippingCalculator", func() {
+		var fakeShippingCalculator *fakes.FakeShippingCalculator
+
+		BeforeEach(func() {
+			fakeShippingCalculator = new(fakes.FakeShippingCalculator)
+		})
+
+		It("retries a transient error from the wrapped calculator until it succeeds", func() {
+			calls := 0
+			fakeShippingCalculator.CalculateStub = func(parcels []utilities.Parcel) (ShippingQuote, error) {
+				calls++
+				if calls < 2 {
+					return ShippingQuote{}, NewTransientError(errors.New("temporary outage"))
+				}
+				return ShippingQuote{Total: money.New(500, "USD")}, nil
+			}
+
+			shippingCalculator := NewBatchRetry(5, time.Millisecond).ShippingCalculator(fakeShippingCalculator)
+
+			quote, err := shippingCalculator.Calculate([]utilities.Parcel{utilities.NewParcel(1, 1)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quote.Total).To(Equal(money.New(500, "USD")))
+			Expect(calls).To(Equal(2))
+		})
+
+		It("surfaces the last error once attempts are exhausted", func() {
+			fakeShippingCalculator.CalculateReturns(ShippingQuote{}, NewTransientError(errors.New("still down")))
+
+			shippingCalculator := NewBatchRetry(3, time.Millisecond).ShippingCalculator(fakeShippingCalculator)
+
+			_, err := shippingCalculator.Calculate([]utilities.Parcel{utilities.NewParcel(1, 1)})
+			Expect(err).To(MatchError("still down"))
+			Expect(fakeShippingCalculator.CalculateCallCount()).To(Equal(3))
+		})
+	})
+})
@@ -0,0 +1,114 @@
+package calculator_test
+
+import (
+	"errors"
+	"sync/atomic"
+
+	. "github.com/jutinko/shipping_cost_calculator/calculator"
+	"github.com/jutinko/shipping_cost_calculator/calculator/fakes"
+	"github.com/jutinko/shipping_cost_calculator/money"
+	"github.com/jutinko/shipping_cost_calculator/utilities"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OrderCalculator GetPrices", func() {
+	var (
+		fakeProductStore       *fakes.FakeProductStore
+		fakeShippingCalculator *fakes.FakeShippingCalculator
+		fakeCurrencyConverter  *fakes.FakeCurrencyConverter
+		orderCalculator        *OrderCalculator
+	)
+
+	BeforeEach(func() {
+		fakeProductStore = new(fakes.FakeProductStore)
+		fakeShippingCalculator = new(fakes.FakeShippingCalculator)
+		fakeCurrencyConverter = new(fakes.FakeCurrencyConverter)
+		fakeShippingCalculator.CalculateReturns(ShippingQuote{Total: money.New(500, "USD")}, nil)
+		fakeCurrencyConverter.ExchangeStub = func(amount money.Cents) (money.Cents, error) {
+			return amount, nil
+		}
+		orderCalculator = NewOrderCalculator(fakeProductStore, fakeShippingCalculator, fakeCurrencyConverter, nil, nil)
+	})
+
+	It("prices every cart in the batch", func() {
+		fakeProductStore.GetStub = func(sku int) (*utilities.Product, error) {
+			return &utilities.Product{Sku: sku, Price: money.New(1000, "USD")}, nil
+		}
+
+		batches := [][]*ProductOrder{
+			{NewProductOrder(20, 1)},
+			{NewProductOrder(21, 2)},
+		}
+
+		results, err := orderCalculator.GetPrices(batches)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(HaveLen(2))
+
+		Expect(results[0].Err).NotTo(HaveOccurred())
+		Expect(results[0].Price.Subtotal).To(Equal(money.New(1000, "USD")))
+
+		Expect(results[1].Err).NotTo(HaveOccurred())
+		Expect(results[1].Price.Subtotal).To(Equal(money.New(2000, "USD")))
+	})
+
+	It("reports a failing cart's error without failing the rest of the batch", func() {
+		fakeProductStore.GetStub = func(sku int) (*utilities.Product, error) {
+			if sku == 99 {
+				return nil, errors.New("no such sku")
+			}
+			return &utilities.Product{Sku: sku, Price: money.New(1000, "USD")}, nil
+		}
+
+		batches := [][]*ProductOrder{
+			{NewProductOrder(99, 1)},
+			{NewProductOrder(20, 1)},
+		}
+
+		results, err := orderCalculator.GetPrices(batches)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(HaveLen(2))
+
+		Expect(results[0].Err).To(MatchError("no such sku"))
+
+		Expect(results[1].Err).NotTo(HaveOccurred())
+		Expect(results[1].Price.Subtotal).To(Equal(money.New(1000, "USD")))
+	})
+
+	It("coalesces a SKU shared across carts into a single ProductStore.Get call", func() {
+		var callCount int32
+		started := make(chan struct{})
+		release := make(chan struct{})
+
+		fakeProductStore.GetStub = func(sku int) (*utilities.Product, error) {
+			if atomic.AddInt32(&callCount, 1) == 1 {
+				close(started)
+				<-release
+			}
+			return &utilities.Product{Sku: sku, Price: money.New(1000, "USD")}, nil
+		}
+
+		batches := [][]*ProductOrder{
+			{NewProductOrder(20, 1)},
+			{NewProductOrder(20, 2)},
+		}
+
+		done := make(chan []Result, 1)
+		go func() {
+			results, err := orderCalculator.GetPrices(batches)
+			Expect(err).NotTo(HaveOccurred())
+			done <- results
+		}()
+
+		Eventually(started).Should(BeClosed())
+		close(release)
+
+		var results []Result
+		Eventually(done).Should(Receive(&results))
+
+		Expect(fakeProductStore.GetCallCount()).To(Equal(1))
+		Expect(results[0].Err).NotTo(HaveOccurred())
+		Expect(results[1].Err).NotTo(HaveOccurred())
+	})
+})
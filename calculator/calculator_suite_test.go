@@ -0,0 +1,13 @@
+package calculator_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCalculator(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Calculator Suite")
+}
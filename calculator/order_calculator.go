@@ -0,0 +1,189 @@
+package calculator
+
+import (
+	"time"
+
+	"github.com/jutinko/shipping_cost_calculator/money"
+	"github.com/jutinko/shipping_cost_calculator/packing"
+	"github.com/jutinko/shipping_cost_calculator/promotions"
+	"github.com/jutinko/shipping_cost_calculator/utilities"
+)
+
+// wholesaleQtyThreshold is the total item count, across every order in a
+// cart, above which the built-in wholesale promotion rule applies.
+const wholesaleQtyThreshold = 15
+
+// ProductStore looks up the catalog product for a SKU.
+type ProductStore interface {
+	Get(sku int) (*utilities.Product, error)
+}
+
+// ShippingCalculator prices shipping one or more parcels, returning their
+// total cost plus each parcel's individual quote.
+type ShippingCalculator interface {
+	Calculate(parcels []utilities.Parcel) (ShippingQuote, error)
+}
+
+// CurrencyConverter exchanges a cents amount into the caller's desired
+// currency.
+type CurrencyConverter interface {
+	Exchange(amount money.Cents) (money.Cents, error)
+}
+
+// ProductOrder is a request to buy Qty units of the product identified by
+// Sku.
+type ProductOrder struct {
+	Sku int
+	Qty int
+}
+
+// NewProductOrder builds a ProductOrder for sku/qty.
+func NewProductOrder(sku, qty int) *ProductOrder {
+	return &ProductOrder{Sku: sku, Qty: qty}
+}
+
+// OrderCalculator prices a cart of ProductOrders: subtotal, promotions,
+// shipping, and currency conversion.
+type OrderCalculator struct {
+	productStore       ProductStore
+	shippingCalculator ShippingCalculator
+	currencyConverter  CurrencyConverter
+	promotions         *promotions.Engine
+	packingStrategy    packing.Strategy
+}
+
+// NewOrderCalculator builds an OrderCalculator from its collaborators. A nil
+// promotionsEngine falls back to an engine with only the built-in wholesale
+// pricing rule, preserving the calculator's historical behavior. A nil
+// packingStrategy falls back to packing.SingleParcel, which ships every
+// order as one aggregated parcel, also preserving historical behavior.
+func NewOrderCalculator(productStore ProductStore, shippingCalculator ShippingCalculator, currencyConverter CurrencyConverter, promotionsEngine *promotions.Engine, packingStrategy packing.Strategy) *OrderCalculator {
+	if promotionsEngine == nil {
+		promotionsEngine = promotions.NewEngine(promotions.NewWholesaleRule(wholesaleQtyThreshold))
+	}
+	if packingStrategy == nil {
+		packingStrategy = packing.SingleParcel{}
+	}
+
+	return &OrderCalculator{
+		productStore:       productStore,
+		shippingCalculator: shippingCalculator,
+		currencyConverter:  currencyConverter,
+		promotions:         promotionsEngine,
+		packingStrategy:    packingStrategy,
+	}
+}
+
+// GetPrice prices every order at its regular rate, runs the promotions
+// engine to compute any discounts, then packs the cart into parcels, adds
+// their shipping cost, and converts the result into the currency
+// converter's target currency. customerTier is passed through to the
+// promotions engine as CartContext.CustomerTier, so rules can key on it
+// (e.g. tiered bulk pricing); pass "" when the caller has none.
+func (c *OrderCalculator) GetPrice(orders []*ProductOrder, customerTier string) (PriceBreakdown, error) {
+	products := make([]*utilities.Product, len(orders))
+	items := make([]packing.Item, len(orders))
+	skuQuantities := make(map[int]int, len(orders))
+
+	var totalQty int
+
+	for i, order := range orders {
+		product, err := c.productStore.Get(order.Sku)
+		if err != nil {
+			return PriceBreakdown{}, err
+		}
+
+		products[i] = product
+		totalQty += order.Qty
+		skuQuantities[order.Sku] += order.Qty
+		items[i] = packing.Item{Weight: product.Weight, Volume: product.Volume, Qty: order.Qty}
+	}
+
+	currency := subtotalCurrency(products)
+
+	lines := make([]promotions.CartLine, len(orders))
+	subtotal := money.Zero(currency)
+	for i, order := range orders {
+		lineTotal, err := products[i].Price.MultiplyQty(order.Qty)
+		if err != nil {
+			return PriceBreakdown{}, err
+		}
+
+		subtotal, err = subtotal.Add(lineTotal)
+		if err != nil {
+			return PriceBreakdown{}, err
+		}
+
+		lines[i] = promotions.CartLine{
+			Sku:                order.Sku,
+			Qty:                order.Qty,
+			RegularUnitPrice:   products[i].Price,
+			WholesaleUnitPrice: products[i].WholePrice,
+		}
+	}
+
+	discounts, err := c.promotions.Run(promotions.CartContext{
+		Lines:         lines,
+		Subtotal:      subtotal,
+		ItemCount:     totalQty,
+		SKUQuantities: skuQuantities,
+		CustomerTier:  customerTier,
+		Now:           time.Now(),
+	})
+	if err != nil {
+		return PriceBreakdown{}, err
+	}
+
+	afterDiscounts := subtotal
+	for _, discount := range discounts {
+		negated, err := discount.Amount.Negate()
+		if err != nil {
+			return PriceBreakdown{}, err
+		}
+
+		afterDiscounts, err = afterDiscounts.Add(negated)
+		if err != nil {
+			return PriceBreakdown{}, err
+		}
+	}
+
+	shippingQuote, err := c.shippingCalculator.Calculate(c.packingStrategy.Pack(items))
+	if err != nil {
+		return PriceBreakdown{}, err
+	}
+
+	taxes := money.Zero(currency)
+
+	total, err := afterDiscounts.Add(shippingQuote.Total)
+	if err != nil {
+		return PriceBreakdown{}, err
+	}
+	total, err = total.Add(taxes)
+	if err != nil {
+		return PriceBreakdown{}, err
+	}
+
+	converted, err := c.currencyConverter.Exchange(total)
+	if err != nil {
+		return PriceBreakdown{}, err
+	}
+
+	return PriceBreakdown{
+		Subtotal:        subtotal,
+		Discounts:       discounts,
+		Shipping:        shippingQuote.Total,
+		ShippingParcels: shippingQuote.Parcels,
+		Taxes:           taxes,
+		Total:           converted,
+	}, nil
+}
+
+// subtotalCurrency returns the currency products are priced in, or "" if
+// there are none (an empty cart has no currency to tag its zero subtotal
+// with).
+func subtotalCurrency(products []*utilities.Product) string {
+	if len(products) == 0 {
+		return ""
+	}
+	return products[0].Price.Currency
+}
@@ -5,6 +5,8 @@ import (
 
 	. "github.com/jutinko/shipping_cost_calculator/calculator"
 	"github.com/jutinko/shipping_cost_calculator/calculator/fakes"
+	"github.com/jutinko/shipping_cost_calculator/money"
+	"github.com/jutinko/shipping_cost_calculator/promotions"
 	"github.com/jutinko/shipping_cost_calculator/utilities"
 
 	. "github.com/onsi/ginkgo"
@@ -24,7 +26,7 @@ var _ = Describe("OrderCalculator", func() {
 		fakeProductStore = new(fakes.FakeProductStore)
 		fakeShippingCalculator = new(fakes.FakeShippingCalculator)
 		fakeCurrencyConverter = new(fakes.FakeCurrencyConverter)
-		orderCalculator = NewOrderCalculator(fakeProductStore, fakeShippingCalculator, fakeCurrencyConverter)
+		orderCalculator = NewOrderCalculator(fakeProductStore, fakeShippingCalculator, fakeCurrencyConverter, nil, nil)
 	})
 
 	AfterEach(func() {
@@ -36,12 +38,12 @@ var _ = Describe("OrderCalculator", func() {
 			orders = append(orders, NewProductOrder(20, 2))
 			fakeProductStore.GetReturns(&utilities.Product{
 				Sku:    20,
-				Price:  14.4,
+				Price:  money.New(1440, "USD"),
 				Weight: 0.4,
 				Volume: 0.99,
 			}, nil)
 
-			_, err := orderCalculator.GetPrice(orders)
+			_, err := orderCalculator.GetPrice(orders, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeProductStore.GetCallCount()).To(Equal(1))
 			Expect(fakeProductStore.GetArgsForCall(0)).To(Equal(20))
@@ -51,57 +53,73 @@ var _ = Describe("OrderCalculator", func() {
 			It("returns the error", func() {
 				orders = append(orders, NewProductOrder(20, 2))
 				fakeProductStore.GetReturns(&utilities.Product{}, errors.New("no-product"))
-				_, err := orderCalculator.GetPrice(orders)
+				_, err := orderCalculator.GetPrice(orders, "")
 				Expect(err).To(MatchError("no-product"))
 			})
 		})
 
+		Context("when the shipping calculator returns an error", func() {
+			It("returns the error", func() {
+				orders = append(orders, NewProductOrder(20, 2))
+				fakeProductStore.GetReturns(&utilities.Product{
+					Sku:    20,
+					Price:  money.New(1440, "USD"),
+					Weight: 0.4,
+					Volume: 0.99,
+				}, nil)
+				fakeShippingCalculator.CalculateReturns(ShippingQuote{}, errors.New("no-shipping"))
+
+				_, err := orderCalculator.GetPrice(orders, "")
+				Expect(err).To(MatchError("no-shipping"))
+			})
+		})
+
 		It("packages the product to a parcel for shipping calculator", func() {
 			orders = append(orders, NewProductOrder(20, 2))
 			fakeProductStore.GetReturns(&utilities.Product{
 				Sku:    20,
-				Price:  14.4,
+				Price:  money.New(1440, "USD"),
 				Weight: 0.4,
 				Volume: 0.99,
 			}, nil)
 
-			_, err := orderCalculator.GetPrice(orders)
+			_, err := orderCalculator.GetPrice(orders, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeShippingCalculator.CalculateCallCount()).To(Equal(1))
-			Expect(fakeShippingCalculator.CalculateArgsForCall(0)).To(Equal(utilities.NewParcel(0.8, 1.98)))
+			Expect(fakeShippingCalculator.CalculateArgsForCall(0)).To(Equal([]utilities.Parcel{utilities.NewParcel(0.8, 1.98)}))
 		})
 
-		It("converts the price and the shipping price to the desired currency", func() {
+		It("converts the subtotal plus shipping to the desired currency", func() {
 			orders = append(orders, NewProductOrder(20, 2))
 			fakeProductStore.GetReturns(&utilities.Product{
 				Sku:    20,
-				Price:  14.4,
+				Price:  money.New(1440, "USD"),
 				Weight: 0.4,
 				Volume: 0.99,
 			}, nil)
 
-			fakeShippingCalculator.CalculateReturns(20)
+			fakeShippingCalculator.CalculateReturns(ShippingQuote{Total: money.New(2000, "USD")}, nil)
 
-			_, err := orderCalculator.GetPrice(orders)
+			_, err := orderCalculator.GetPrice(orders, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(fakeCurrencyConverter.ExchangeCallCount()).To(Equal(1))
-			Expect(fakeCurrencyConverter.ExchangeArgsForCall(0)).To(BeNumerically("==", 48.8))
+			Expect(fakeCurrencyConverter.ExchangeArgsForCall(0)).To(Equal(money.New(4880, "USD")))
 		})
 
-		It("returns the price in the desired currency", func() {
+		It("returns the breakdown's total in the desired currency", func() {
 			orders = append(orders, NewProductOrder(20, 2))
-			fakeCurrencyConverter.ExchangeReturns(1314)
+			fakeCurrencyConverter.ExchangeReturns(money.New(131400, "USD"), nil)
 			fakeProductStore.GetReturns(&utilities.Product{
 				Sku:    20,
-				Price:  14.4,
+				Price:  money.New(1440, "USD"),
 				Weight: 0.4,
 				Volume: 0.99,
 			}, nil)
 
-			price, err := orderCalculator.GetPrice(orders)
+			breakdown, err := orderCalculator.GetPrice(orders, "")
 			Expect(err).NotTo(HaveOccurred())
-			Expect(price).To(BeNumerically("==", 1314))
+			Expect(breakdown.Total).To(Equal(money.New(131400, "USD")))
 		})
 
 		Context("when there are multiple orders", func() {
@@ -111,12 +129,12 @@ var _ = Describe("OrderCalculator", func() {
 
 				fakeProductStore.GetReturns(&utilities.Product{
 					Sku:    20,
-					Price:  14.4,
+					Price:  money.New(1440, "USD"),
 					Weight: 0.4,
 					Volume: 0.99,
 				}, nil)
 
-				_, err := orderCalculator.GetPrice(orders)
+				_, err := orderCalculator.GetPrice(orders, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(fakeProductStore.GetCallCount()).To(Equal(2))
 				Expect(fakeProductStore.GetArgsForCall(0)).To(Equal(20))
@@ -124,11 +142,11 @@ var _ = Describe("OrderCalculator", func() {
 			})
 
 			Context("when the order list is empty", func() {
-				It("should return 0", func() {
-					price, err := orderCalculator.GetPrice(orders)
+				It("should return a zero breakdown", func() {
+					breakdown, err := orderCalculator.GetPrice(orders, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(fakeProductStore.GetCallCount()).To(Equal(0))
-					Expect(price).To(BeNumerically("==", 0))
+					Expect(breakdown.Total).To(Equal(money.Cents{}))
 				})
 			})
 
@@ -146,14 +164,14 @@ var _ = Describe("OrderCalculator", func() {
 					return nil, nil
 				}
 
-				_, err := orderCalculator.GetPrice(orders)
+				_, err := orderCalculator.GetPrice(orders, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(fakeShippingCalculator.CalculateCallCount()).To(Equal(1))
-				Expect(fakeShippingCalculator.CalculateArgsForCall(0)).To(Equal(utilities.NewParcel(40, 40008)))
+				Expect(fakeShippingCalculator.CalculateArgsForCall(0)).To(Equal([]utilities.Parcel{utilities.NewParcel(40, 40008)}))
 			})
 
 			Context("when the order has more than 15 items", func() {
-				It("should use the wholesale price", func() {
+				It("discounts the cart down to wholesale pricing", func() {
 					for i := 0; i < 15; i++ {
 						orders = append(orders, NewProductOrder(i, 2))
 					}
@@ -161,21 +179,133 @@ var _ = Describe("OrderCalculator", func() {
 
 					fakeProductStore.GetReturns(&utilities.Product{
 						Sku:        20,
-						WholePrice: 1,
-						Price:      14.4,
+						WholePrice: money.New(100, "USD"),
+						Price:      money.New(1440, "USD"),
 						Weight:     0.4,
 						Volume:     0.99,
 					}, nil)
 
-					fakeShippingCalculator.CalculateReturns(20)
+					fakeShippingCalculator.CalculateReturns(ShippingQuote{Total: money.New(2000, "USD")}, nil)
 
-					_, err := orderCalculator.GetPrice(orders)
+					breakdown, err := orderCalculator.GetPrice(orders, "")
 					Expect(err).NotTo(HaveOccurred())
 
+					Expect(breakdown.Discounts).To(Equal([]promotions.Adjustment{
+						{Description: "wholesale pricing", Amount: money.New(65660, "USD")},
+					}))
+
 					Expect(fakeCurrencyConverter.ExchangeCallCount()).To(Equal(1))
-					Expect(fakeCurrencyConverter.ExchangeArgsForCall(0)).To(BeNumerically("==", 69))
+					Expect(fakeCurrencyConverter.ExchangeArgsForCall(0)).To(Equal(money.New(6900, "USD")))
 				})
 			})
 		})
+
+		Context("when a custom promotions engine is configured", func() {
+			It("applies the registered rule's discount", func() {
+				fiveOff, err := promotions.CompileRule(promotions.Config{
+					Description: "$5 off",
+					When:        "true",
+					Discount:    "5",
+					Currency:    "USD",
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				orderCalculator = NewOrderCalculator(fakeProductStore, fakeShippingCalculator, fakeCurrencyConverter, promotions.NewEngine(fiveOff), nil)
+
+				orders = append(orders, NewProductOrder(20, 2))
+				fakeProductStore.GetReturns(&utilities.Product{
+					Sku:    20,
+					Price:  money.New(1440, "USD"),
+					Weight: 0.4,
+					Volume: 0.99,
+				}, nil)
+
+				breakdown, err := orderCalculator.GetPrice(orders, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(breakdown.Discounts).To(Equal([]promotions.Adjustment{
+					{Description: "$5 off", Amount: money.New(500, "USD")},
+				}))
+			})
+
+			It("errors when a rule's discount is denominated in a different currency than the cart", func() {
+				fiveEurOff, err := promotions.CompileRule(promotions.Config{
+					Description: "5 EUR off",
+					When:        "true",
+					Discount:    "5",
+					Currency:    "EUR",
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				orderCalculator = NewOrderCalculator(fakeProductStore, fakeShippingCalculator, fakeCurrencyConverter, promotions.NewEngine(fiveEurOff), nil)
+
+				orders = append(orders, NewProductOrder(20, 2))
+				fakeProductStore.GetReturns(&utilities.Product{
+					Sku:    20,
+					Price:  money.New(1440, "USD"),
+					Weight: 0.4,
+					Volume: 0.99,
+				}, nil)
+
+				_, err = orderCalculator.GetPrice(orders, "")
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("applies a rule keyed on a per-SKU quantity", func() {
+				bulkSkuDiscount, err := promotions.CompileRule(promotions.Config{
+					Description: "bulk SKU 20 discount",
+					When:        `cart.sku_quantities["20"] >= 5`,
+					Discount:    "5",
+					Currency:    "USD",
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				orderCalculator = NewOrderCalculator(fakeProductStore, fakeShippingCalculator, fakeCurrencyConverter, promotions.NewEngine(bulkSkuDiscount), nil)
+
+				orders = append(orders, NewProductOrder(20, 3))
+				orders = append(orders, NewProductOrder(20, 2))
+				fakeProductStore.GetReturns(&utilities.Product{
+					Sku:    20,
+					Price:  money.New(1440, "USD"),
+					Weight: 0.4,
+					Volume: 0.99,
+				}, nil)
+
+				breakdown, err := orderCalculator.GetPrice(orders, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(breakdown.Discounts).To(Equal([]promotions.Adjustment{
+					{Description: "bulk SKU 20 discount", Amount: money.New(500, "USD")},
+				}))
+			})
+
+			It("applies a rule keyed on the customer's tier", func() {
+				goldTierDiscount, err := promotions.CompileRule(promotions.Config{
+					Description: "gold tier discount",
+					When:        `cart.tier == "gold"`,
+					Discount:    "5",
+					Currency:    "USD",
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				orderCalculator = NewOrderCalculator(fakeProductStore, fakeShippingCalculator, fakeCurrencyConverter, promotions.NewEngine(goldTierDiscount), nil)
+
+				orders = append(orders, NewProductOrder(20, 1))
+				fakeProductStore.GetReturns(&utilities.Product{
+					Sku:    20,
+					Price:  money.New(1440, "USD"),
+					Weight: 0.4,
+					Volume: 0.99,
+				}, nil)
+
+				breakdown, err := orderCalculator.GetPrice(orders, "gold")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(breakdown.Discounts).To(Equal([]promotions.Adjustment{
+					{Description: "gold tier discount", Amount: money.New(500, "USD")},
+				}))
+
+				breakdown, err = orderCalculator.GetPrice(orders, "silver")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(breakdown.Discounts).To(BeEmpty())
+			})
+		})
 	})
-})
\ No newline at end of file
+})
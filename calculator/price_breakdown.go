@@ -0,0 +1,18 @@
+package calculator
+
+import (
+	"github.com/jutinko/shipping_cost_calculator/money"
+	"github.com/jutinko/shipping_cost_calculator/promotions"
+)
+
+// PriceBreakdown is the itemized result of pricing a cart: the subtotal
+// before any promotion, the discounts that were applied to it, shipping
+// (total and per-parcel), taxes, and the final converted total.
+type PriceBreakdown struct {
+	Subtotal        money.Cents             `json:"subtotal"`
+	Discounts       []promotions.Adjustment `json:"discounts"`
+	Shipping        money.Cents             `json:"shipping"`
+	ShippingParcels []ParcelQuote           `json:"shipping_parcels"`
+	Taxes           money.Cents             `json:"taxes"`
+	Total           money.Cents             `json:"total"`
+}
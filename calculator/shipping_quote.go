@@ -0,0 +1,19 @@
+package calculator
+
+import (
+	"github.com/jutinko/shipping_cost_calculator/money"
+	"github.com/jutinko/shipping_cost_calculator/utilities"
+)
+
+// ParcelQuote is the shipping cost quoted for a single parcel.
+type ParcelQuote struct {
+	Parcel utilities.Parcel `json:"parcel"`
+	Cost   money.Cents      `json:"cost"`
+}
+
+// ShippingQuote is the result of pricing every parcel a cart packs into:
+// the total cost across parcels, and each parcel's individual quote.
+type ShippingQuote struct {
+	Total   money.Cents   `json:"total"`
+	Parcels []ParcelQuote `json:"parcels"`
+}
@@ -0,0 +1,62 @@
+// Package testsupport spins up in-process HTTP stubs for the external FX
+// and shipping-rate services, so adapter tests can exercise the real HTTP
+// client code hermetically instead of only against counterfeit fakes.
+package testsupport
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+)
+
+// StubFX starts a stub FX-rate service that responds to every request with
+// rateMicros (an exchange rate expressed as rate * 1e6).
+func StubFX(rateMicros int64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]int64{"rate_micros": rateMicros})
+	}))
+}
+
+// StubFXCounting starts a stub FX-rate service identical to StubFX, plus a
+// counter of how many requests it has served, so caching tests can assert
+// directly on request counts instead of inferring them indirectly.
+func StubFXCounting(rateMicros int64) (*httptest.Server, *int64) {
+	var requests int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]int64{"rate_micros": rateMicros})
+	}))
+	return server, &requests
+}
+
+// StubFXError starts a stub FX-rate service that always responds with
+// status and body, for exercising the FX client's error handling.
+func StubFXError(status int, body string) *httptest.Server {
+	return stubError(status, body)
+}
+
+// StubShipping starts a stub shipping-rate service that responds to every
+// request with costCents, the shipping cost in cents.
+func StubShipping(costCents int64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]int64{"cost_cents": costCents})
+	}))
+}
+
+// StubShippingError starts a stub shipping-rate service that always
+// responds with status and body, for exercising the shipping client's
+// error handling.
+func StubShippingError(status int, body string) *httptest.Server {
+	return stubError(status, body)
+}
+
+func stubError(status int, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+}
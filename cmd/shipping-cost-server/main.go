@@ -0,0 +1,76 @@
+// Command shipping-cost-server runs the shipping cost calculator as an HTTP
+// service, pricing carts against live FX-rate and shipping-rate adapters.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/jutinko/shipping_cost_calculator/calculator"
+	"github.com/jutinko/shipping_cost_calculator/fx"
+	"github.com/jutinko/shipping_cost_calculator/server"
+	"github.com/jutinko/shipping_cost_calculator/shipping"
+	"github.com/jutinko/shipping_cost_calculator/utilities"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	catalogPath := flag.String("catalog", "", "path to a JSON file listing the product catalog")
+	fxBaseURL := flag.String("fx-base-url", "", "base URL of the FX-rate service")
+	fxRefreshInterval := flag.Duration("fx-refresh-interval", time.Minute, "how often to refresh cached FX rates")
+	shippingBaseURL := flag.String("shipping-base-url", "", "base URL of the shipping-rate service")
+	shippingCurrency := flag.String("shipping-currency", "USD", "currency the shipping-rate service quotes in")
+	flag.Parse()
+
+	if *catalogPath == "" || *fxBaseURL == "" || *shippingBaseURL == "" {
+		log.Fatal("shipping-cost-server: -catalog, -fx-base-url and -shipping-base-url are required")
+	}
+
+	products, err := loadCatalog(*catalogPath)
+	if err != nil {
+		log.Fatalf("shipping-cost-server: %s", err)
+	}
+
+	productStore := utilities.NewInMemoryStore(products...)
+	shippingCalculator := shipping.NewClient(*shippingBaseURL, *shippingCurrency)
+	currencyConverters := cachingCurrencyConverterFactory(*fxBaseURL, *fxRefreshInterval)
+
+	srv := server.NewServer(productStore, shippingCalculator, currencyConverters, nil, nil)
+
+	log.Printf("shipping-cost-server: listening on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, srv.Routes()))
+}
+
+// loadCatalog reads and parses the JSON product catalog at path.
+func loadCatalog(path string) ([]*utilities.Product, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return utilities.LoadCatalogJSON(data)
+}
+
+// cachingCurrencyConverterFactory returns a CurrencyConverterFactory that
+// keeps one fx.Client per target currency, so repeated requests for the
+// same currency reuse that client's own cached exchange rate.
+func cachingCurrencyConverterFactory(fxBaseURL string, refreshInterval time.Duration) server.CurrencyConverterFactory {
+	var mu sync.Mutex
+	converters := map[string]calculator.CurrencyConverter{}
+
+	return func(currency string) calculator.CurrencyConverter {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if converter, ok := converters[currency]; ok {
+			return converter
+		}
+
+		converter := fx.NewClient(fxBaseURL, currency, refreshInterval)
+		converters[currency] = converter
+		return converter
+	}
+}
@@ -0,0 +1,93 @@
+// Package fx implements a calculator.CurrencyConverter backed by an
+// external FX-rate service.
+package fx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jutinko/shipping_cost_calculator/money"
+)
+
+type rateResponse struct {
+	RateMicros int64 `json:"rate_micros"`
+}
+
+// Client is a calculator.CurrencyConverter that fetches its exchange rate
+// from an external FX-rate service and caches it for RefreshInterval, so
+// GetPrice calls do not pay a network round trip every time.
+type Client struct {
+	BaseURL         string
+	TargetCurrency  string
+	RefreshInterval time.Duration
+	HTTPClient      *http.Client
+
+	mu         sync.Mutex
+	rateMicros int64
+	fetchedAt  time.Time
+}
+
+// NewClient builds a Client that fetches rates from baseURL, converts into
+// targetCurrency, and refreshes its cached rate every refreshInterval.
+func NewClient(baseURL, targetCurrency string, refreshInterval time.Duration) *Client {
+	return &Client{
+		BaseURL:         baseURL,
+		TargetCurrency:  targetCurrency,
+		RefreshInterval: refreshInterval,
+		HTTPClient:      http.DefaultClient,
+	}
+}
+
+// Exchange converts amount into TargetCurrency using the cached or
+// freshly-fetched rate.
+func (c *Client) Exchange(amount money.Cents) (money.Cents, error) {
+	rateMicros, err := c.rate()
+	if err != nil {
+		return money.Cents{}, err
+	}
+
+	converted, err := money.ConvertMicros(amount.Amount, rateMicros)
+	if err != nil {
+		return money.Cents{}, err
+	}
+
+	return money.New(converted, c.TargetCurrency), nil
+}
+
+func (c *Client) rate() (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if time.Since(c.fetchedAt) < c.RefreshInterval {
+		return c.rateMicros, nil
+	}
+
+	resp, err := c.httpClient().Get(fmt.Sprintf("%s/rate?currency=%s", c.BaseURL, c.TargetCurrency))
+	if err != nil {
+		return 0, fmt.Errorf("fx: fetching rate: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("fx: unexpected status fetching rate: %d", resp.StatusCode)
+	}
+
+	var out rateResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return 0, fmt.Errorf("fx: decoding rate response: %w", err)
+	}
+
+	c.rateMicros = out.RateMicros
+	c.fetchedAt = time.Now()
+	return c.rateMicros, nil
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
@@ -0,0 +1,13 @@
+package fx_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestFx(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Fx Suite")
+}
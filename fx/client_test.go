@@ -0,0 +1,50 @@
+package fx_test
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/jutinko/shipping_cost_calculator/calculator/testsupport"
+	. "github.com/jutinko/shipping_cost_calculator/fx"
+	"github.com/jutinko/shipping_cost_calculator/money"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Client", func() {
+	It("converts using the rate served by the FX service", func() {
+		stub := testsupport.StubFX(1500000)
+		defer stub.Close()
+
+		client := NewClient(stub.URL, "EUR", time.Minute)
+
+		converted, err := client.Exchange(money.New(1000, "USD"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(converted).To(Equal(money.New(1500, "EUR")))
+	})
+
+	It("caches the rate within the refresh interval", func() {
+		stub, requests := testsupport.StubFXCounting(1000000)
+		defer stub.Close()
+
+		client := NewClient(stub.URL, "EUR", time.Minute)
+
+		_, err := client.Exchange(money.New(1000, "USD"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.Exchange(money.New(1000, "USD"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(atomic.LoadInt64(requests)).To(Equal(int64(1)))
+	})
+
+	It("returns an error when the FX service fails", func() {
+		stub := testsupport.StubFXError(500, "boom")
+		defer stub.Close()
+
+		client := NewClient(stub.URL, "EUR", time.Minute)
+
+		_, err := client.Exchange(money.New(1000, "USD"))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,143 @@
+// Package money represents monetary amounts as integer minor units (cents)
+// so that price arithmetic never loses precision to float64 rounding.
+package money
+
+import (
+	"fmt"
+	"math"
+)
+
+// ErrPriceOverflow is returned whenever a multiplication or addition of cent
+// amounts would overflow int64.
+var ErrPriceOverflow = fmt.Errorf("money: price overflow")
+
+// microsScale is the denominator of a rate expressed in micros, i.e. a rate
+// of 1.0 is represented as 1_000_000.
+const microsScale = 1000000
+
+// Cents is an amount of money expressed in integer minor units (e.g. cents
+// for USD), tagged with the currency it is denominated in.
+type Cents struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// New returns a Cents value for the given amount of minor units.
+func New(amount int64, currency string) Cents {
+	return Cents{Amount: amount, Currency: currency}
+}
+
+// Zero returns the zero amount in the given currency.
+func Zero(currency string) Cents {
+	return Cents{Currency: currency}
+}
+
+// FromFloat converts a major-unit float (e.g. dollars) into Cents, rounding
+// to the nearest minor unit. It exists only at API boundaries; internal
+// arithmetic should stay in Cents.
+func FromFloat(amount float64, currency string) Cents {
+	return Cents{Amount: int64(math.Round(amount * 100)), Currency: currency}
+}
+
+// ToFloat renders a Cents value back into major units. It exists only at
+// API boundaries; internal arithmetic should stay in Cents.
+func (c Cents) ToFloat() float64 {
+	return float64(c.Amount) / 100
+}
+
+// Add returns c + other. An empty Currency on either side is treated as
+// untagged and adopts the other side's currency; otherwise the currencies
+// must match. Returns ErrPriceOverflow if the sum overflows int64.
+func (c Cents) Add(other Cents) (Cents, error) {
+	currency, err := reconcileCurrency(c.Currency, other.Currency)
+	if err != nil {
+		return Cents{}, err
+	}
+
+	sum, err := addInt64(c.Amount, other.Amount)
+	if err != nil {
+		return Cents{}, err
+	}
+
+	return Cents{Amount: sum, Currency: currency}, nil
+}
+
+// MultiplyQty returns c multiplied by qty, as in pricing a line item.
+// Returns ErrPriceOverflow if the product overflows int64.
+func (c Cents) MultiplyQty(qty int) (Cents, error) {
+	product, err := multiplyInt64(c.Amount, int64(qty))
+	if err != nil {
+		return Cents{}, err
+	}
+
+	return Cents{Amount: product, Currency: c.Currency}, nil
+}
+
+// Negate returns -c. Returns ErrPriceOverflow if c.Amount is math.MinInt64,
+// whose negation doesn't fit in int64.
+func (c Cents) Negate() (Cents, error) {
+	negated, err := negateInt64(c.Amount)
+	if err != nil {
+		return Cents{}, err
+	}
+	return Cents{Amount: negated, Currency: c.Currency}, nil
+}
+
+// ConvertMicros converts a cents amount using an exchange rate expressed in
+// micros (rate * 1e6) by adding half a unit and dividing, which rounds half
+// up for non-negative results; for a negative product the division
+// truncates toward zero (e.g. -1.6 becomes -1). Returns ErrPriceOverflow if
+// the conversion overflows int64.
+func ConvertMicros(cents int64, rateMicros int64) (int64, error) {
+	product, err := multiplyInt64(cents, rateMicros)
+	if err != nil {
+		return 0, err
+	}
+
+	rounded, err := addInt64(product, microsScale/2)
+	if err != nil {
+		return 0, err
+	}
+
+	return rounded / microsScale, nil
+}
+
+func reconcileCurrency(a, b string) (string, error) {
+	if a == "" {
+		return b, nil
+	}
+	if b == "" || b == a {
+		return a, nil
+	}
+	return "", fmt.Errorf("money: currency mismatch: %s vs %s", a, b)
+}
+
+func addInt64(a, b int64) (int64, error) {
+	if (a > 0 && b > 0 && a > math.MaxInt64-b) || (a < 0 && b < 0 && a < math.MinInt64-b) {
+		return 0, ErrPriceOverflow
+	}
+	return a + b, nil
+}
+
+func multiplyInt64(a, b int64) (int64, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	if (a == math.MinInt64 && b == -1) || (b == math.MinInt64 && a == -1) {
+		return 0, ErrPriceOverflow
+	}
+	result := a * b
+	if result/b != a {
+		return 0, ErrPriceOverflow
+	}
+	return result, nil
+}
+
+// negateInt64 returns -a, erroring instead of silently wrapping when a is
+// math.MinInt64, whose negation doesn't fit in int64.
+func negateInt64(a int64) (int64, error) {
+	if a == math.MinInt64 {
+		return 0, ErrPriceOverflow
+	}
+	return -a, nil
+}
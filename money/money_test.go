@@ -0,0 +1,103 @@
+package money_test
+
+import (
+	"math"
+
+	"github.com/jutinko/shipping_cost_calculator/money"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cents", func() {
+	Describe("Add", func() {
+		It("sums two amounts in the same currency", func() {
+			sum, err := money.New(1000, "USD").Add(money.New(250, "USD"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sum).To(Equal(money.New(1250, "USD")))
+		})
+
+		It("adopts the other side's currency when untagged", func() {
+			sum, err := money.Zero("").Add(money.New(250, "USD"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sum).To(Equal(money.New(250, "USD")))
+		})
+
+		It("errors on mismatched currencies", func() {
+			_, err := money.New(1000, "USD").Add(money.New(250, "EUR"))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("errors on overflow", func() {
+			_, err := money.New(math.MaxInt64, "USD").Add(money.New(1, "USD"))
+			Expect(err).To(MatchError(money.ErrPriceOverflow))
+		})
+	})
+
+	Describe("MultiplyQty", func() {
+		It("multiplies the amount by the quantity", func() {
+			product, err := money.New(1440, "USD").MultiplyQty(3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(product).To(Equal(money.New(4320, "USD")))
+		})
+
+		It("errors on overflow", func() {
+			_, err := money.New(math.MaxInt64/2, "USD").MultiplyQty(3)
+			Expect(err).To(MatchError(money.ErrPriceOverflow))
+		})
+
+		It("errors on the MinInt64 * -1 overflow that a naive result/b check misses", func() {
+			_, err := money.New(math.MinInt64, "USD").MultiplyQty(-1)
+			Expect(err).To(MatchError(money.ErrPriceOverflow))
+		})
+	})
+
+	Describe("ConvertMicros", func() {
+		It("converts using a rate expressed in micros", func() {
+			converted, err := money.ConvertMicros(1000, 1500000)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(converted).To(Equal(int64(1500)))
+		})
+
+		It("rounds half up", func() {
+			converted, err := money.ConvertMicros(3, 1333333)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(converted).To(Equal(int64(4)))
+		})
+
+		It("errors on overflow", func() {
+			_, err := money.ConvertMicros(math.MaxInt64, math.MaxInt64)
+			Expect(err).To(MatchError(money.ErrPriceOverflow))
+		})
+
+		It("errors on the MinInt64 * -1 overflow that a naive result/b check misses", func() {
+			_, err := money.ConvertMicros(math.MinInt64, -1)
+			Expect(err).To(MatchError(money.ErrPriceOverflow))
+		})
+	})
+
+	Describe("Negate", func() {
+		It("flips the sign of a positive amount", func() {
+			negated, err := money.New(500, "USD").Negate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(negated).To(Equal(money.New(-500, "USD")))
+		})
+
+		It("flips the sign of a negative amount", func() {
+			negated, err := money.New(-500, "USD").Negate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(negated).To(Equal(money.New(500, "USD")))
+		})
+
+		It("errors instead of silently wrapping MinInt64", func() {
+			_, err := money.New(math.MinInt64, "USD").Negate()
+			Expect(err).To(MatchError(money.ErrPriceOverflow))
+		})
+	})
+
+	Describe("FromFloat/ToFloat", func() {
+		It("round-trips through cents", func() {
+			Expect(money.FromFloat(14.4, "USD").ToFloat()).To(BeNumerically("==", 14.4))
+		})
+	})
+})
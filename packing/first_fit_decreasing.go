@@ -0,0 +1,87 @@
+package packing
+
+import (
+	"sort"
+
+	"github.com/jutinko/shipping_cost_calculator/utilities"
+)
+
+// FirstFitDecreasing packs items into one or more Parcels using the
+// classic first-fit-decreasing bin-packing heuristic: items are expanded
+// by quantity to individual units and sorted by descending weight, then
+// each unit is placed into the first open parcel whose remaining weight
+// and volume can both still accommodate it, or a new parcel if none can.
+type FirstFitDecreasing struct {
+	MaxWeight float64
+	MaxVolume float64
+
+	// DimWeightDivisor, if non-zero, makes each parcel's chargeable
+	// weight max(actualKg, volume/DimWeightDivisor) rather than just its
+	// actual weight, mirroring carriers that bill low-density,
+	// high-volume parcels by dimensional weight.
+	DimWeightDivisor float64
+}
+
+// NewFirstFitDecreasing builds a FirstFitDecreasing strategy that caps
+// each parcel at maxWeight and maxVolume.
+func NewFirstFitDecreasing(maxWeight, maxVolume float64) FirstFitDecreasing {
+	return FirstFitDecreasing{MaxWeight: maxWeight, MaxVolume: maxVolume}
+}
+
+type bin struct {
+	weight, volume float64
+}
+
+// Pack implements Strategy.
+func (s FirstFitDecreasing) Pack(items []Item) []utilities.Parcel {
+	units := expandByQty(items)
+	sort.SliceStable(units, func(i, j int) bool { return units[i].Weight > units[j].Weight })
+
+	var bins []bin
+	for _, unit := range units {
+		placed := false
+		for i := range bins {
+			if bins[i].weight+unit.Weight <= s.MaxWeight && bins[i].volume+unit.Volume <= s.MaxVolume {
+				bins[i].weight += unit.Weight
+				bins[i].volume += unit.Volume
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			bins = append(bins, bin{weight: unit.Weight, volume: unit.Volume})
+		}
+	}
+
+	parcels := make([]utilities.Parcel, len(bins))
+	for i, b := range bins {
+		parcels[i] = utilities.NewParcel(s.chargeableWeight(b), b.volume)
+	}
+	return parcels
+}
+
+// chargeableWeight applies DimWeightDivisor, if configured, to bin's actual
+// weight.
+func (s FirstFitDecreasing) chargeableWeight(b bin) float64 {
+	if s.DimWeightDivisor == 0 {
+		return b.weight
+	}
+
+	dimWeight := b.volume / s.DimWeightDivisor
+	if dimWeight > b.weight {
+		return dimWeight
+	}
+	return b.weight
+}
+
+// expandByQty expands each item into Qty individual units of Qty 1, one
+// per item instance.
+func expandByQty(items []Item) []Item {
+	var units []Item
+	for _, item := range items {
+		for i := 0; i < item.Qty; i++ {
+			units = append(units, Item{Weight: item.Weight, Volume: item.Volume, Qty: 1})
+		}
+	}
+	return units
+}
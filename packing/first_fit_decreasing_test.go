@@ -0,0 +1,111 @@
+package packing_test
+
+import (
+	. "github.com/jutinko/shipping_cost_calculator/packing"
+	"github.com/jutinko/shipping_cost_calculator/utilities"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FirstFitDecreasing", func() {
+	It("packs units that land exactly at the weight and volume caps into one parcel", func() {
+		strategy := NewFirstFitDecreasing(10, 10)
+
+		parcels := strategy.Pack([]Item{
+			{Weight: 5, Volume: 5, Qty: 2},
+		})
+
+		Expect(parcels).To(Equal([]utilities.Parcel{utilities.NewParcel(10, 10)}))
+	})
+
+	It("opens a new parcel for the unit that pushes weight one over the cap", func() {
+		strategy := NewFirstFitDecreasing(10, 100)
+
+		parcels := strategy.Pack([]Item{
+			{Weight: 5, Volume: 1, Qty: 3},
+		})
+
+		Expect(parcels).To(Equal([]utilities.Parcel{
+			utilities.NewParcel(10, 2),
+			utilities.NewParcel(5, 1),
+		}))
+	})
+
+	It("opens a new parcel for the unit that pushes volume one over the cap", func() {
+		strategy := NewFirstFitDecreasing(100, 10)
+
+		parcels := strategy.Pack([]Item{
+			{Weight: 1, Volume: 5, Qty: 3},
+		})
+
+		Expect(parcels).To(Equal([]utilities.Parcel{
+			utilities.NewParcel(2, 10),
+			utilities.NewParcel(1, 5),
+		}))
+	})
+
+	It("gives a unit heavier than the cap its own parcel", func() {
+		strategy := NewFirstFitDecreasing(10, 100)
+
+		parcels := strategy.Pack([]Item{
+			{Weight: 15, Volume: 1, Qty: 1},
+		})
+
+		Expect(parcels).To(Equal([]utilities.Parcel{utilities.NewParcel(15, 1)}))
+	})
+
+	It("packs zero-weight, zero-volume items into a single parcel", func() {
+		strategy := NewFirstFitDecreasing(10, 10)
+
+		parcels := strategy.Pack([]Item{
+			{Weight: 0, Volume: 0, Qty: 5},
+		})
+
+		Expect(parcels).To(Equal([]utilities.Parcel{utilities.NewParcel(0, 0)}))
+	})
+
+	It("sorts units by descending weight before packing, to use fewer parcels than first-fit in input order would", func() {
+		strategy := NewFirstFitDecreasing(10, 100)
+
+		// In input order (1, 2, 8, 9), first-fit without sorting needs
+		// three parcels: {1, 2} fits together, then 8 and 9 each need
+		// their own. Sorted descending (9, 8, 2, 1), 1 backfills into 9's
+		// parcel and 2 backfills into 8's, for two parcels instead.
+		parcels := strategy.Pack([]Item{
+			{Weight: 1, Volume: 1, Qty: 1},
+			{Weight: 2, Volume: 1, Qty: 1},
+			{Weight: 8, Volume: 1, Qty: 1},
+			{Weight: 9, Volume: 1, Qty: 1},
+		})
+
+		Expect(parcels).To(Equal([]utilities.Parcel{
+			utilities.NewParcel(10, 2),
+			utilities.NewParcel(10, 2),
+		}))
+	})
+
+	It("charges the dimensional weight when it exceeds the actual weight", func() {
+		strategy := FirstFitDecreasing{MaxWeight: 100, MaxVolume: 1000, DimWeightDivisor: 10}
+
+		parcels := strategy.Pack([]Item{
+			{Weight: 2, Volume: 500, Qty: 1},
+		})
+
+		Expect(parcels).To(Equal([]utilities.Parcel{utilities.NewParcel(50, 500)}))
+	})
+
+	It("charges the actual weight when it exceeds the dimensional weight", func() {
+		strategy := FirstFitDecreasing{MaxWeight: 100, MaxVolume: 1000, DimWeightDivisor: 10}
+
+		parcels := strategy.Pack([]Item{
+			{Weight: 20, Volume: 10, Qty: 1},
+		})
+
+		Expect(parcels).To(Equal([]utilities.Parcel{utilities.NewParcel(20, 10)}))
+	})
+
+	It("packs nothing for an empty cart", func() {
+		Expect(NewFirstFitDecreasing(10, 10).Pack(nil)).To(BeEmpty())
+	})
+})
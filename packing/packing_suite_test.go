@@ -0,0 +1,13 @@
+package packing_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestPacking(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Packing Suite")
+}
@@ -0,0 +1,38 @@
+// Package packing implements strategies for splitting a cart's items into
+// one or more shippable parcels.
+package packing
+
+import "github.com/jutinko/shipping_cost_calculator/utilities"
+
+// Item is Qty units of a single product's weight and volume, the unit a
+// Strategy packs into parcels.
+type Item struct {
+	Weight float64
+	Volume float64
+	Qty    int
+}
+
+// Strategy splits a cart's items into the parcels it should ship as.
+type Strategy interface {
+	Pack(items []Item) []utilities.Parcel
+}
+
+// SingleParcel packs every item into one aggregated Parcel, regardless of
+// size. It is OrderCalculator's default strategy, preserving its original
+// behavior from before per-parcel packing existed.
+type SingleParcel struct{}
+
+// Pack implements Strategy.
+func (SingleParcel) Pack(items []Item) []utilities.Parcel {
+	if len(items) == 0 {
+		return nil
+	}
+
+	var weight, volume float64
+	for _, item := range items {
+		weight += item.Weight * float64(item.Qty)
+		volume += item.Volume * float64(item.Qty)
+	}
+
+	return []utilities.Parcel{utilities.NewParcel(weight, volume)}
+}
@@ -0,0 +1,24 @@
+package packing_test
+
+import (
+	. "github.com/jutinko/shipping_cost_calculator/packing"
+	"github.com/jutinko/shipping_cost_calculator/utilities"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SingleParcel", func() {
+	It("aggregates every item into one parcel", func() {
+		parcels := SingleParcel{}.Pack([]Item{
+			{Weight: 1, Volume: 2, Qty: 3},
+			{Weight: 4, Volume: 5, Qty: 2},
+		})
+
+		Expect(parcels).To(Equal([]utilities.Parcel{utilities.NewParcel(11, 16)}))
+	})
+
+	It("packs nothing for an empty cart", func() {
+		Expect(SingleParcel{}.Pack(nil)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,48 @@
+package packing
+
+import "github.com/jutinko/shipping_cost_calculator/utilities"
+
+// WeightCapped packs items into one or more Parcels, each holding at most
+// MaxWeight of accumulated unit weight: it fills a parcel to capacity
+// before opening the next one. A single unit heavier than MaxWeight gets a
+// parcel of its own, which then exceeds MaxWeight. It does not consider
+// volume.
+type WeightCapped struct {
+	MaxWeight float64
+}
+
+// NewWeightCapped builds a WeightCapped strategy that caps each parcel at
+// maxWeight.
+func NewWeightCapped(maxWeight float64) WeightCapped {
+	return WeightCapped{MaxWeight: maxWeight}
+}
+
+// Pack implements Strategy.
+func (s WeightCapped) Pack(items []Item) []utilities.Parcel {
+	var parcels []utilities.Parcel
+	var weight, volume float64
+	pending := false
+
+	flush := func() {
+		if !pending {
+			return
+		}
+		parcels = append(parcels, utilities.NewParcel(weight, volume))
+		weight, volume = 0, 0
+		pending = false
+	}
+
+	for _, item := range items {
+		for i := 0; i < item.Qty; i++ {
+			if pending && weight+item.Weight > s.MaxWeight {
+				flush()
+			}
+			weight += item.Weight
+			volume += item.Volume
+			pending = true
+		}
+	}
+	flush()
+
+	return parcels
+}
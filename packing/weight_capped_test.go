@@ -0,0 +1,58 @@
+package packing_test
+
+import (
+	. "github.com/jutinko/shipping_cost_calculator/packing"
+	"github.com/jutinko/shipping_cost_calculator/utilities"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WeightCapped", func() {
+	It("packs items that land exactly at the cap into one parcel", func() {
+		strategy := NewWeightCapped(10)
+
+		parcels := strategy.Pack([]Item{
+			{Weight: 5, Volume: 1, Qty: 2},
+		})
+
+		Expect(parcels).To(Equal([]utilities.Parcel{utilities.NewParcel(10, 2)}))
+	})
+
+	It("opens a new parcel for the unit that pushes weight one over the cap", func() {
+		strategy := NewWeightCapped(10)
+
+		parcels := strategy.Pack([]Item{
+			{Weight: 5, Volume: 1, Qty: 3},
+		})
+
+		Expect(parcels).To(Equal([]utilities.Parcel{
+			utilities.NewParcel(10, 2),
+			utilities.NewParcel(5, 1),
+		}))
+	})
+
+	It("gives an item heavier than the cap its own parcel", func() {
+		strategy := NewWeightCapped(10)
+
+		parcels := strategy.Pack([]Item{
+			{Weight: 15, Volume: 1, Qty: 1},
+		})
+
+		Expect(parcels).To(Equal([]utilities.Parcel{utilities.NewParcel(15, 1)}))
+	})
+
+	It("packs zero-weight items into a single parcel without ever hitting the cap", func() {
+		strategy := NewWeightCapped(10)
+
+		parcels := strategy.Pack([]Item{
+			{Weight: 0, Volume: 0, Qty: 5},
+		})
+
+		Expect(parcels).To(Equal([]utilities.Parcel{utilities.NewParcel(0, 0)}))
+	})
+
+	It("packs nothing for an empty cart", func() {
+		Expect(NewWeightCapped(10).Pack(nil)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,25 @@
+package promotions
+
+import "gopkg.in/yaml.v3"
+
+// LoadRulesYAML parses data as a YAML list of rule Configs and compiles
+// each into an ExprRule, in file order.
+func LoadRulesYAML(data []byte) ([]*ExprRule, error) {
+	var configs []Config
+	if err := yaml.Unmarshal(data, &configs); err != nil {
+		return nil, err
+	}
+	return compileRules(configs)
+}
+
+func compileRules(configs []Config) ([]*ExprRule, error) {
+	rules := make([]*ExprRule, len(configs))
+	for i, cfg := range configs {
+		rule, err := CompileRule(cfg)
+		if err != nil {
+			return nil, err
+		}
+		rules[i] = rule
+	}
+	return rules, nil
+}
@@ -0,0 +1,127 @@
+package promotions
+
+import (
+	"fmt"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+
+	"github.com/jutinko/shipping_cost_calculator/money"
+)
+
+// Config is the declarative, file-friendly shape of an ExprRule. It is the
+// unit decoded from a YAML or JSON rules file.
+type Config struct {
+	// Description is copied verbatim onto the Adjustment this rule
+	// produces.
+	Description string `yaml:"description" json:"description"`
+
+	// When is an expr-lang predicate evaluated against the cart, e.g.
+	// "cart.items.count >= 15". The rule applies when it evaluates true.
+	When string `yaml:"when" json:"when"`
+
+	// Discount is an expr-lang expression evaluated against the cart that
+	// yields the discount amount in major currency units (e.g. dollars),
+	// e.g. "cart.subtotal * 0.1". It is rounded to the nearest cent.
+	Discount string `yaml:"discount" json:"discount"`
+
+	// ExclusiveGroup, when non-empty, marks this rule as mutually
+	// exclusive with every other rule sharing the same group: only the
+	// first applicable rule in a group runs.
+	ExclusiveGroup string `yaml:"exclusive_group" json:"exclusive_group"`
+
+	// Currency is the currency of the cart this rule is priced for.
+	Currency string `yaml:"currency" json:"currency"`
+}
+
+// ExprRule is a Rule whose predicate and discount amount are expr-lang
+// expressions, compiled once at registration time and evaluated against
+// every cart.
+type ExprRule struct {
+	cfg    Config
+	when   *vm.Program
+	amount *vm.Program
+}
+
+// CompileRule compiles cfg's When and Discount expressions and returns the
+// resulting ExprRule. Compilation happens once, at registration time, so
+// evaluating the rule against a cart never re-parses the expression.
+func CompileRule(cfg Config) (*ExprRule, error) {
+	when, err := expr.Compile(cfg.When)
+	if err != nil {
+		return nil, fmt.Errorf("promotions: compiling when expression %q: %w", cfg.When, err)
+	}
+
+	amount, err := expr.Compile(cfg.Discount)
+	if err != nil {
+		return nil, fmt.Errorf("promotions: compiling discount expression %q: %w", cfg.Discount, err)
+	}
+
+	return &ExprRule{cfg: cfg, when: when, amount: amount}, nil
+}
+
+// Applies evaluates the compiled When expression against cart.
+func (r *ExprRule) Applies(cart CartContext) bool {
+	result, err := expr.Run(r.when, exprEnv(cart))
+	if err != nil {
+		return false
+	}
+	applies, _ := result.(bool)
+	return applies
+}
+
+// Apply evaluates the compiled Discount expression against cart and rounds
+// the result to the nearest cent.
+func (r *ExprRule) Apply(cart CartContext) (Adjustment, error) {
+	result, err := expr.Run(r.amount, exprEnv(cart))
+	if err != nil {
+		return Adjustment{}, fmt.Errorf("promotions: evaluating discount expression %q: %w", r.cfg.Discount, err)
+	}
+	return Adjustment{
+		Description: r.cfg.Description,
+		Amount:      money.FromFloat(toFloat64(result), r.cfg.Currency),
+	}, nil
+}
+
+// toFloat64 normalizes the numeric result of a Discount expression: expr
+// evaluates an integer literal like "5" to an int, not a float64.
+func toFloat64(v any) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case int:
+		return float64(n)
+	case int64:
+		return float64(n)
+	default:
+		return 0
+	}
+}
+
+// Group returns the rule's exclusive group, if any.
+func (r *ExprRule) Group() string {
+	return r.cfg.ExclusiveGroup
+}
+
+// exprEnv projects a CartContext into the map that expr-lang expressions
+// are evaluated against, so rules files can use lowercase, dotted paths
+// such as "cart.items.count" and "cart.subtotal" regardless of the Go
+// field names on CartContext.
+func exprEnv(cart CartContext) map[string]any {
+	skuQuantities := make(map[string]any, len(cart.SKUQuantities))
+	for sku, qty := range cart.SKUQuantities {
+		skuQuantities[fmt.Sprint(sku)] = qty
+	}
+
+	return map[string]any{
+		"cart": map[string]any{
+			"subtotal": cart.Subtotal.ToFloat(),
+			"items": map[string]any{
+				"count": cart.ItemCount,
+			},
+			"sku_quantities": skuQuantities,
+			"tier":           cart.CustomerTier,
+			"now":            cart.Now,
+		},
+	}
+}
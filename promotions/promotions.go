@@ -0,0 +1,108 @@
+// Package promotions implements a pluggable engine of discount/promotion
+// rules that run, in registration order, against a cart to produce a list
+// of price adjustments.
+package promotions
+
+import (
+	"time"
+
+	"github.com/jutinko/shipping_cost_calculator/money"
+)
+
+// CartLine is a single SKU/quantity line of a cart, along with the unit
+// prices a Rule needs to compute its adjustment.
+type CartLine struct {
+	Sku                int
+	Qty                int
+	RegularUnitPrice   money.Cents
+	WholesaleUnitPrice money.Cents
+}
+
+// CartContext is the frozen snapshot of a cart that rules evaluate against.
+// It is built once per GetPrice call and never mutated while rules run, so
+// every rule in a stack sees the same totals regardless of evaluation
+// order.
+type CartContext struct {
+	Lines         []CartLine
+	Subtotal      money.Cents
+	ItemCount     int
+	SKUQuantities map[int]int
+	CustomerTier  string
+	Now           time.Time
+}
+
+// Adjustment is the result of a Rule applying to a cart: a human-readable
+// description and the amount to discount from the subtotal.
+type Adjustment struct {
+	Description string      `json:"description"`
+	Amount      money.Cents `json:"amount"`
+}
+
+// Rule is a single promotion or discount. Applies decides whether the rule
+// is relevant to a cart; Apply computes the resulting Adjustment and is only
+// called when Applies returned true. Apply returns an error if computing
+// the adjustment overflows or otherwise fails, rather than silently
+// producing a wrong amount.
+type Rule interface {
+	Applies(cart CartContext) bool
+	Apply(cart CartContext) (Adjustment, error)
+}
+
+// Grouped is implemented by rules that participate in mutual exclusion:
+// once a rule in a non-empty group has applied, later rules sharing that
+// group are skipped for the rest of the run.
+type Grouped interface {
+	Group() string
+}
+
+// Engine runs a stack of rules, in registration order, against a cart.
+type Engine struct {
+	rules []Rule
+}
+
+// NewEngine builds an Engine seeded with rules, evaluated in the order
+// given.
+func NewEngine(rules ...Rule) *Engine {
+	return &Engine{rules: rules}
+}
+
+// Register appends rule to the end of the stacking order.
+func (e *Engine) Register(rule Rule) {
+	e.rules = append(e.rules, rule)
+}
+
+// Run evaluates every registered rule against cart, in stacking order, and
+// returns the adjustments of the rules that applied. Once a rule in a given
+// Grouped group has applied, later rules sharing that group are skipped. Run
+// returns an error, without any adjustments, as soon as a rule's Apply
+// fails.
+func (e *Engine) Run(cart CartContext) ([]Adjustment, error) {
+	var adjustments []Adjustment
+	appliedGroups := map[string]bool{}
+
+	for _, rule := range e.rules {
+		if grouped, ok := rule.(Grouped); ok {
+			if group := grouped.Group(); group != "" && appliedGroups[group] {
+				continue
+			}
+		}
+
+		if !rule.Applies(cart) {
+			continue
+		}
+
+		adjustment, err := rule.Apply(cart)
+		if err != nil {
+			return nil, err
+		}
+		adjustments = append(adjustments, adjustment)
+
+		if grouped, ok := rule.(Grouped); ok {
+			if group := grouped.Group(); group != "" {
+				appliedGroups[group] = true
+			}
+		}
+	}
+
+	return adjustments, nil
+}
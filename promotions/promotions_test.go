@@ -0,0 +1,162 @@
+package promotions_test
+
+import (
+	"github.com/jutinko/shipping_cost_calculator/money"
+	. "github.com/jutinko/shipping_cost_calculator/promotions"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Engine", func() {
+	var cart CartContext
+
+	BeforeEach(func() {
+		cart = CartContext{
+			Subtotal:  money.New(10000, "USD"),
+			ItemCount: 16,
+		}
+	})
+
+	It("runs rules in registration order and stacks their adjustments", func() {
+		tenPercentOff, err := CompileRule(Config{
+			Description: "10% off",
+			When:        "true",
+			Discount:    "cart.subtotal * 0.1",
+			Currency:    "USD",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		fiveOff, err := CompileRule(Config{
+			Description: "$5 off",
+			When:        "true",
+			Discount:    "5",
+			Currency:    "USD",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		engine := NewEngine(tenPercentOff, fiveOff)
+		adjustments, err := engine.Run(cart)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(adjustments).To(Equal([]Adjustment{
+			{Description: "10% off", Amount: money.New(1000, "USD")},
+			{Description: "$5 off", Amount: money.New(500, "USD")},
+		}))
+	})
+
+	It("skips rules whose predicate does not apply", func() {
+		neverApplies, err := CompileRule(Config{
+			Description: "never",
+			When:        "cart.items.count > 1000",
+			Discount:    "5",
+			Currency:    "USD",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		engine := NewEngine(neverApplies)
+		adjustments, err := engine.Run(cart)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(adjustments).To(BeEmpty())
+	})
+
+	It("only applies the first rule in a mutually exclusive group", func() {
+		first, err := CompileRule(Config{
+			Description:    "first",
+			When:           "true",
+			Discount:       "5",
+			ExclusiveGroup: "seasonal",
+			Currency:       "USD",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		second, err := CompileRule(Config{
+			Description:    "second",
+			When:           "true",
+			Discount:       "10",
+			ExclusiveGroup: "seasonal",
+			Currency:       "USD",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		engine := NewEngine(first, second)
+		adjustments, err := engine.Run(cart)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(adjustments).To(Equal([]Adjustment{
+			{Description: "first", Amount: money.New(500, "USD")},
+		}))
+	})
+
+	It("rounds the discount amount to the nearest cent", func() {
+		oneThird, err := CompileRule(Config{
+			Description: "one third off",
+			When:        "true",
+			Discount:    "cart.subtotal / 3",
+			Currency:    "USD",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		engine := NewEngine(oneThird)
+		adjustments, err := engine.Run(cart)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(adjustments).To(Equal([]Adjustment{
+			{Description: "one third off", Amount: money.New(3333, "USD")},
+		}))
+	})
+
+	It("loads rules from a YAML file and preserves their stacking order", func() {
+		rulesFile := []byte(`
+- description: 10% off
+  when: "cart.items.count >= 15"
+  discount: "cart.subtotal * 0.1"
+  currency: USD
+- description: "$5 off"
+  when: "true"
+  discount: "5"
+  currency: USD
+`)
+
+		rules, err := LoadRulesYAML(rulesFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rules).To(HaveLen(2))
+
+		engine := NewEngine(rules[0], rules[1])
+		adjustments, err := engine.Run(cart)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(adjustments).To(Equal([]Adjustment{
+			{Description: "10% off", Amount: money.New(1000, "USD")},
+			{Description: "$5 off", Amount: money.New(500, "USD")},
+		}))
+	})
+})
+
+var _ = Describe("WholesaleRule", func() {
+	It("discounts every line down to its wholesale price once the threshold is exceeded", func() {
+		cart := CartContext{
+			Subtotal:  money.New(28800, "USD"),
+			ItemCount: 20,
+			Lines: []CartLine{
+				{Sku: 1, Qty: 20, RegularUnitPrice: money.New(1440, "USD"), WholesaleUnitPrice: money.New(1000, "USD")},
+			},
+		}
+
+		rule := NewWholesaleRule(15)
+		Expect(rule.Applies(cart)).To(BeTrue())
+
+		adjustment, err := rule.Apply(cart)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(adjustment).To(Equal(Adjustment{
+			Description: "wholesale pricing",
+			Amount:      money.New(8800, "USD"),
+		}))
+	})
+
+	It("does not apply under the threshold", func() {
+		cart := CartContext{ItemCount: 10}
+		rule := NewWholesaleRule(15)
+		Expect(rule.Applies(cart)).To(BeFalse())
+	})
+})
@@ -0,0 +1,57 @@
+package promotions
+
+import "github.com/jutinko/shipping_cost_calculator/money"
+
+// WholesaleRule switches every line to its wholesale unit price once the
+// cart's combined item count exceeds Threshold.
+type WholesaleRule struct {
+	Threshold int
+}
+
+// NewWholesaleRule builds a WholesaleRule that triggers once a cart holds
+// more than threshold items.
+func NewWholesaleRule(threshold int) WholesaleRule {
+	return WholesaleRule{Threshold: threshold}
+}
+
+// Applies reports whether the cart's combined item count exceeds the
+// threshold.
+func (r WholesaleRule) Applies(cart CartContext) bool {
+	return cart.ItemCount > r.Threshold
+}
+
+// Apply returns the discount that brings every line down from its regular
+// price to its wholesale price.
+func (r WholesaleRule) Apply(cart CartContext) (Adjustment, error) {
+	currency := cart.Subtotal.Currency
+	discount := money.Zero(currency)
+
+	for _, line := range cart.Lines {
+		regular, err := line.RegularUnitPrice.MultiplyQty(line.Qty)
+		if err != nil {
+			return Adjustment{}, err
+		}
+
+		wholesale, err := line.WholesaleUnitPrice.MultiplyQty(line.Qty)
+		if err != nil {
+			return Adjustment{}, err
+		}
+
+		negatedWholesale, err := wholesale.Negate()
+		if err != nil {
+			return Adjustment{}, err
+		}
+
+		lineDiscount, err := regular.Add(negatedWholesale)
+		if err != nil {
+			return Adjustment{}, err
+		}
+
+		discount, err = discount.Add(lineDiscount)
+		if err != nil {
+			return Adjustment{}, err
+		}
+	}
+
+	return Adjustment{Description: "wholesale pricing", Amount: discount}, nil
+}
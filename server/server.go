@@ -0,0 +1,88 @@
+// Package server exposes calculator.OrderCalculator over HTTP.
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jutinko/shipping_cost_calculator/calculator"
+	"github.com/jutinko/shipping_cost_calculator/packing"
+	"github.com/jutinko/shipping_cost_calculator/promotions"
+)
+
+// CurrencyConverterFactory returns the CurrencyConverter to use for
+// converting a cart's total into currency. Implementations typically cache
+// one converter per currency, since a converter like fx.Client already
+// caches its own exchange rate internally.
+type CurrencyConverterFactory func(currency string) calculator.CurrencyConverter
+
+// Server exposes an OrderCalculator over HTTP.
+type Server struct {
+	productStore       calculator.ProductStore
+	shippingCalculator calculator.ShippingCalculator
+	currencyConverters CurrencyConverterFactory
+	promotionsEngine   *promotions.Engine
+	packingStrategy    packing.Strategy
+}
+
+// NewServer builds a Server from its collaborators. A nil promotionsEngine
+// or packingStrategy falls back to OrderCalculator's defaults.
+func NewServer(productStore calculator.ProductStore, shippingCalculator calculator.ShippingCalculator, currencyConverters CurrencyConverterFactory, promotionsEngine *promotions.Engine, packingStrategy packing.Strategy) *Server {
+	return &Server{
+		productStore:       productStore,
+		shippingCalculator: shippingCalculator,
+		currencyConverters: currencyConverters,
+		promotionsEngine:   promotionsEngine,
+		packingStrategy:    packingStrategy,
+	}
+}
+
+// Routes returns the Server's HTTP handler.
+func (s *Server) Routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/price", s.handlePrice)
+	return mux
+}
+
+type priceRequestOrder struct {
+	Sku int `json:"sku"`
+	Qty int `json:"qty"`
+}
+
+type priceRequest struct {
+	Orders       []priceRequestOrder `json:"orders"`
+	Currency     string              `json:"currency"`
+	CustomerTier string              `json:"customer_tier"`
+}
+
+// handlePrice decodes a priceRequest, prices it with an OrderCalculator
+// wired to the Server's collaborators, and writes the resulting
+// PriceBreakdown as JSON.
+func (s *Server) handlePrice(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req priceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "decoding request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	orders := make([]*calculator.ProductOrder, len(req.Orders))
+	for i, order := range req.Orders {
+		orders[i] = calculator.NewProductOrder(order.Sku, order.Qty)
+	}
+
+	orderCalculator := calculator.NewOrderCalculator(s.productStore, s.shippingCalculator, s.currencyConverters(req.Currency), s.promotionsEngine, s.packingStrategy)
+
+	breakdown, err := orderCalculator.GetPrice(orders, req.CustomerTier)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(breakdown)
+}
@@ -0,0 +1,89 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/jutinko/shipping_cost_calculator/calculator"
+	"github.com/jutinko/shipping_cost_calculator/calculator/testsupport"
+	"github.com/jutinko/shipping_cost_calculator/fx"
+	"github.com/jutinko/shipping_cost_calculator/money"
+	. "github.com/jutinko/shipping_cost_calculator/server"
+	"github.com/jutinko/shipping_cost_calculator/shipping"
+	"github.com/jutinko/shipping_cost_calculator/utilities"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		fxStub       *httptest.Server
+		shippingStub *httptest.Server
+		srv          *httptest.Server
+	)
+
+	BeforeEach(func() {
+		fxStub = testsupport.StubFX(2000000)
+		shippingStub = testsupport.StubShipping(500)
+
+		productStore := utilities.NewInMemoryStore(&utilities.Product{
+			Sku:    20,
+			Price:  money.New(1000, "USD"),
+			Weight: 0.4,
+			Volume: 0.99,
+		})
+		shippingCalculator := shipping.NewClient(shippingStub.URL, "USD")
+		currencyConverters := func(currency string) calculator.CurrencyConverter {
+			return fx.NewClient(fxStub.URL, currency, time.Minute)
+		}
+
+		srv = httptest.NewServer(NewServer(productStore, shippingCalculator, currencyConverters, nil, nil).Routes())
+	})
+
+	AfterEach(func() {
+		srv.Close()
+		shippingStub.Close()
+		fxStub.Close()
+	})
+
+	It("prices an order over HTTP using real FX and shipping adapters", func() {
+		body, err := json.Marshal(map[string]interface{}{
+			"orders":   []map[string]int{{"sku": 20, "qty": 2}},
+			"currency": "EUR",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		resp, err := http.Post(srv.URL+"/price", "application/json", bytes.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+		var breakdown calculator.PriceBreakdown
+		Expect(json.NewDecoder(resp.Body).Decode(&breakdown)).To(Succeed())
+		Expect(breakdown.Total).To(Equal(money.New(5000, "EUR")))
+	})
+
+	It("rejects an order for a SKU that isn't stocked", func() {
+		body, err := json.Marshal(map[string]interface{}{
+			"orders":   []map[string]int{{"sku": 99, "qty": 1}},
+			"currency": "EUR",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		resp, err := http.Post(srv.URL+"/price", "application/json", bytes.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusUnprocessableEntity))
+	})
+
+	It("rejects a malformed request body", func() {
+		resp, err := http.Post(srv.URL+"/price", "application/json", bytes.NewReader([]byte("{")))
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -0,0 +1,95 @@
+// Package shipping implements a calculator.ShippingCalculator backed by an
+// external carrier's shipping-rate service.
+package shipping
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/jutinko/shipping_cost_calculator/calculator"
+	"github.com/jutinko/shipping_cost_calculator/money"
+	"github.com/jutinko/shipping_cost_calculator/utilities"
+)
+
+type quoteRequest struct {
+	Weight float64 `json:"weight"`
+	Volume float64 `json:"volume"`
+}
+
+type quoteResponse struct {
+	CostCents int64 `json:"cost_cents"`
+}
+
+// Client is a calculator.ShippingCalculator that quotes a parcel's shipping
+// cost from an external carrier's rate service.
+type Client struct {
+	BaseURL    string
+	Currency   string
+	HTTPClient *http.Client
+}
+
+// NewClient builds a Client that quotes parcels against baseURL's
+// shipping-rate service, priced in currency.
+func NewClient(baseURL, currency string) *Client {
+	return &Client{
+		BaseURL:    baseURL,
+		Currency:   currency,
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+// Calculate quotes the shipping cost of every parcel from the carrier,
+// individually, and sums them into a total.
+func (c *Client) Calculate(parcels []utilities.Parcel) (calculator.ShippingQuote, error) {
+	quote := calculator.ShippingQuote{Parcels: make([]calculator.ParcelQuote, len(parcels))}
+
+	for i, parcel := range parcels {
+		cost, err := c.quote(parcel)
+		if err != nil {
+			return calculator.ShippingQuote{}, err
+		}
+
+		quote.Parcels[i] = calculator.ParcelQuote{Parcel: parcel, Cost: cost}
+
+		quote.Total, err = quote.Total.Add(cost)
+		if err != nil {
+			return calculator.ShippingQuote{}, err
+		}
+	}
+
+	return quote, nil
+}
+
+// quote fetches the shipping cost of a single parcel from the carrier.
+func (c *Client) quote(parcel utilities.Parcel) (money.Cents, error) {
+	body, err := json.Marshal(quoteRequest{Weight: parcel.Weight, Volume: parcel.Volume})
+	if err != nil {
+		return money.Cents{}, fmt.Errorf("shipping: encoding quote request: %w", err)
+	}
+
+	resp, err := c.httpClient().Post(c.BaseURL+"/quote", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return money.Cents{}, fmt.Errorf("shipping: requesting quote: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return money.Cents{}, fmt.Errorf("shipping: unexpected status requesting quote: %d", resp.StatusCode)
+	}
+
+	var out quoteResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return money.Cents{}, fmt.Errorf("shipping: decoding quote response: %w", err)
+	}
+
+	return money.New(out.CostCents, c.Currency), nil
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
@@ -0,0 +1,13 @@
+package shipping_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestShipping(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Shipping Suite")
+}
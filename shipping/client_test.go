@@ -0,0 +1,63 @@
+package shipping_test
+
+import (
+	"github.com/jutinko/shipping_cost_calculator/calculator"
+	"github.com/jutinko/shipping_cost_calculator/calculator/testsupport"
+	"github.com/jutinko/shipping_cost_calculator/money"
+	. "github.com/jutinko/shipping_cost_calculator/shipping"
+	"github.com/jutinko/shipping_cost_calculator/utilities"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Client", func() {
+	It("quotes the cost of a single parcel served by the shipping service", func() {
+		stub := testsupport.StubShipping(1200)
+		defer stub.Close()
+
+		client := NewClient(stub.URL, "EUR")
+
+		parcel := utilities.NewParcel(2, 3)
+		quote, err := client.Calculate([]utilities.Parcel{parcel})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(quote.Total).To(Equal(money.New(1200, "EUR")))
+		Expect(quote.Parcels).To(Equal([]calculator.ParcelQuote{
+			{Parcel: parcel, Cost: money.New(1200, "EUR")},
+		}))
+	})
+
+	It("quotes each parcel individually and sums them into the total", func() {
+		stub := testsupport.StubShipping(1200)
+		defer stub.Close()
+
+		client := NewClient(stub.URL, "EUR")
+
+		quote, err := client.Calculate([]utilities.Parcel{utilities.NewParcel(2, 3), utilities.NewParcel(5, 1)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(quote.Total).To(Equal(money.New(2400, "EUR")))
+		Expect(quote.Parcels).To(HaveLen(2))
+	})
+
+	It("quotes nothing for an empty parcel list", func() {
+		stub := testsupport.StubShipping(1200)
+		defer stub.Close()
+
+		client := NewClient(stub.URL, "EUR")
+
+		quote, err := client.Calculate(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(quote.Total).To(Equal(money.Cents{}))
+		Expect(quote.Parcels).To(BeEmpty())
+	})
+
+	It("returns an error when the shipping service fails", func() {
+		stub := testsupport.StubShippingError(500, "boom")
+		defer stub.Close()
+
+		client := NewClient(stub.URL, "EUR")
+
+		_, err := client.Calculate([]utilities.Parcel{utilities.NewParcel(2, 3)})
+		Expect(err).To(HaveOccurred())
+	})
+})
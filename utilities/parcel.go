@@ -0,0 +1,13 @@
+package utilities
+
+// Parcel is the weight and volume of a single package shipped for an
+// order.
+type Parcel struct {
+	Weight float64 `json:"weight"`
+	Volume float64 `json:"volume"`
+}
+
+// NewParcel builds a Parcel from a total weight and volume.
+func NewParcel(weight, volume float64) Parcel {
+	return Parcel{Weight: weight, Volume: volume}
+}
@@ -0,0 +1,57 @@
+package utilities
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jutinko/shipping_cost_calculator/money"
+)
+
+// Product is a sellable SKU, priced in cents.
+type Product struct {
+	Sku int `json:"sku"`
+
+	// Price is the regular unit price.
+	Price money.Cents `json:"price"`
+
+	// WholePrice is the unit price applied once an order qualifies for
+	// wholesale pricing.
+	WholePrice money.Cents `json:"whole_price"`
+
+	Weight float64 `json:"weight"`
+	Volume float64 `json:"volume"`
+}
+
+// InMemoryStore is a calculator.ProductStore backed by an in-memory
+// catalog, keyed by SKU.
+type InMemoryStore struct {
+	products map[int]*Product
+}
+
+// NewInMemoryStore builds an InMemoryStore seeded with products.
+func NewInMemoryStore(products ...*Product) *InMemoryStore {
+	store := &InMemoryStore{products: make(map[int]*Product, len(products))}
+	for _, product := range products {
+		store.products[product.Sku] = product
+	}
+	return store
+}
+
+// Get returns the catalog product for sku, or an error if it isn't stocked.
+func (s *InMemoryStore) Get(sku int) (*Product, error) {
+	product, ok := s.products[sku]
+	if !ok {
+		return nil, fmt.Errorf("utilities: no product for sku %d", sku)
+	}
+	return product, nil
+}
+
+// LoadCatalogJSON parses data as a JSON list of Products, the catalog
+// format read by the shipping-cost-server's -catalog flag.
+func LoadCatalogJSON(data []byte) ([]*Product, error) {
+	var products []*Product
+	if err := json.Unmarshal(data, &products); err != nil {
+		return nil, fmt.Errorf("utilities: decoding catalog: %w", err)
+	}
+	return products, nil
+}